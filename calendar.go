@@ -0,0 +1,238 @@
+package date
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Calendar determines whether a given [Date] is a business day. It allows
+// business-day arithmetic on [Date] to be parameterised over whatever
+// notion of "open" or "closed" a particular caller needs.
+type Calendar interface {
+	IsBusinessDay(d Date) bool
+}
+
+// WeekdayCalendar is a [Calendar] that treats Monday through Friday as
+// business days, and knows nothing about holidays.
+type WeekdayCalendar struct{}
+
+// IsBusinessDay returns true if d is not a Saturday or Sunday.
+func (WeekdayCalendar) IsBusinessDay(d Date) bool {
+	return !d.IsWeekend()
+}
+
+// HolidayCalendar is a [Calendar] that treats Monday through Friday as
+// business days, except for a configured set of holidays.
+//
+// Holidays are kept in a sorted slice rather than a map, trading O(log n)
+// lookups (via binary search) for a smaller memory footprint.
+type HolidayCalendar struct {
+	holidays []Date
+}
+
+// NewHolidayCalendar returns a [HolidayCalendar] for the given holidays.
+// Duplicate dates are collapsed.
+func NewHolidayCalendar(holidays []Date) *HolidayCalendar {
+	sorted := make([]Date, len(holidays))
+	copy(sorted, holidays)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Before(sorted[j]) })
+
+	deduped := sorted[:0]
+	for i, d := range sorted {
+		if i == 0 || !d.Equal(deduped[len(deduped)-1]) {
+			deduped = append(deduped, d)
+		}
+	}
+	return &HolidayCalendar{holidays: deduped}
+}
+
+// holidayIndex returns the index of d in the sorted holiday slice, found by
+// binary search, or -1 if d is not a holiday.
+func (c *HolidayCalendar) holidayIndex(d Date) int {
+	i := sort.Search(len(c.holidays), func(i int) bool { return !c.holidays[i].Before(d) })
+	if i < len(c.holidays) && c.holidays[i].Equal(d) {
+		return i
+	}
+	return -1
+}
+
+// IsHoliday returns true if d is one of the calendar's configured holidays,
+// regardless of whether it falls on a weekend.
+func (c *HolidayCalendar) IsHoliday(d Date) bool {
+	return c.holidayIndex(d) >= 0
+}
+
+// IsBusinessDay returns true if d is a weekday and not one of the calendar's
+// holidays.
+func (c *HolidayCalendar) IsBusinessDay(d Date) bool {
+	return !d.IsWeekend() && !c.IsHoliday(d)
+}
+
+// addBusinessDays walks from d to the Date n business days away. Unlike the
+// generic day-by-day walk in [Date.AddBusinessDays], it uses the sorted
+// holiday index to jump straight past a run of consecutive holidays in one
+// step (an O(log n) binary search plus the length of the run) rather than
+// testing each of those days individually.
+func (c *HolidayCalendar) addBusinessDays(d Date, n int) Date {
+	step := 1
+	if n < 0 {
+		step = -1
+		n = -n
+	}
+	cur := d
+	for n > 0 {
+		cur = cur.AddDate(0, 0, step)
+		if cur.IsWeekend() {
+			continue
+		}
+		i := c.holidayIndex(cur)
+		if i < 0 {
+			n--
+			continue
+		}
+		if step > 0 {
+			for i+1 < len(c.holidays) && c.holidays[i+1].Equal(cur.AddDate(0, 0, 1)) {
+				i++
+				cur = cur.AddDate(0, 0, 1)
+			}
+		} else {
+			for i > 0 && c.holidays[i-1].Equal(cur.AddDate(0, 0, -1)) {
+				i--
+				cur = cur.AddDate(0, 0, -1)
+			}
+		}
+	}
+	return cur
+}
+
+// LoadHolidaysCSV reads a [HolidayCalendar] from r, which must contain one
+// ISO 8601 date per record in its first field. Blank records are skipped.
+func LoadHolidaysCSV(r io.Reader) (*HolidayCalendar, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	holidays := make([]Date, 0, len(records))
+	for _, record := range records {
+		if len(record) == 0 || strings.TrimSpace(record[0]) == "" {
+			continue
+		}
+		var d Date
+		if err := d.UnmarshalText([]byte(strings.TrimSpace(record[0]))); err != nil {
+			return nil, fmt.Errorf("date: invalid holiday %q: %w", record[0], err)
+		}
+		holidays = append(holidays, d)
+	}
+	return NewHolidayCalendar(holidays), nil
+}
+
+// composedCalendar ANDs together the results of several [Calendar] values.
+type composedCalendar []Calendar
+
+// IsBusinessDay returns true only if every composed [Calendar] treats d as a
+// business day.
+func (c composedCalendar) IsBusinessDay(d Date) bool {
+	for _, cal := range c {
+		if !cal.IsBusinessDay(d) {
+			return false
+		}
+	}
+	return true
+}
+
+// Compose combines several [Calendar] values into one, treating a [Date] as
+// a business day only if all of cals agree. This lets a country calendar be
+// combined with, say, a company-specific calendar.
+func Compose(cals ...Calendar) Calendar {
+	return composedCalendar(cals)
+}
+
+// IsWeekend returns true if the [Date] falls on a Saturday or Sunday.
+func (d Date) IsWeekend() bool {
+	wd := d.Weekday()
+	return wd == time.Saturday || wd == time.Sunday
+}
+
+// IsBusinessDay returns true if c considers the [Date] a business day.
+func (d Date) IsBusinessDay(c Calendar) bool {
+	return c.IsBusinessDay(d)
+}
+
+// AddBusinessDays returns the [Date] n business days after d according to c.
+// A negative n walks backwards. d itself is never counted, even if it is a
+// business day.
+//
+// This is an O(n) day-by-day walk for an arbitrary [Calendar]. When c is a
+// [*HolidayCalendar] it instead uses the calendar's sorted holiday index to
+// skip whole runs of holidays at once; see [HolidayCalendar.addBusinessDays].
+func (d Date) AddBusinessDays(n int, c Calendar) Date {
+	if hc, ok := c.(*HolidayCalendar); ok {
+		return hc.addBusinessDays(d, n)
+	}
+	step := 1
+	if n < 0 {
+		step = -1
+		n = -n
+	}
+	cur := d
+	for n > 0 {
+		cur = cur.AddDate(0, 0, step)
+		if c.IsBusinessDay(cur) {
+			n--
+		}
+	}
+	return cur
+}
+
+// BusinessDaysUntil returns the number of business days, according to c,
+// between d and e. The result is negative if e is before d. In either
+// direction e, the day walked to, is counted, while d, the day walked from,
+// is not.
+func (d Date) BusinessDaysUntil(e Date, c Calendar) int {
+	count := 0
+	cur := d
+	switch {
+	case e.After(d):
+		for cur.Before(e) {
+			cur = cur.AddDate(0, 0, 1)
+			if c.IsBusinessDay(cur) {
+				count++
+			}
+		}
+	case e.Before(d):
+		for cur.After(e) {
+			cur = cur.AddDate(0, 0, -1)
+			if c.IsBusinessDay(cur) {
+				count--
+			}
+		}
+	}
+	return count
+}
+
+// NextBusinessDay returns the first business day, according to c, strictly
+// after d.
+func (d Date) NextBusinessDay(c Calendar) Date {
+	next := d.AddDate(0, 0, 1)
+	for !c.IsBusinessDay(next) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next
+}
+
+// PrevBusinessDay returns the first business day, according to c, strictly
+// before d.
+func (d Date) PrevBusinessDay(c Calendar) Date {
+	prev := d.AddDate(0, 0, -1)
+	for !c.IsBusinessDay(prev) {
+		prev = prev.AddDate(0, 0, -1)
+	}
+	return prev
+}
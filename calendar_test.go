@@ -0,0 +1,123 @@
+package date_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/svip/date"
+)
+
+func TestWeekdayCalendar(t *testing.T) {
+	var c date.WeekdayCalendar
+	sat := date.NewDate(2024, time.June, 8)
+	mon := date.NewDate(2024, time.June, 10)
+	if c.IsBusinessDay(sat) {
+		t.Fatal("Expected Saturday to not be a business day")
+	}
+	if !c.IsBusinessDay(mon) {
+		t.Fatal("Expected Monday to be a business day")
+	}
+}
+
+func TestHolidayCalendar(t *testing.T) {
+	independence := date.NewDate(2024, time.July, 4)
+	c := date.NewHolidayCalendar([]date.Date{independence})
+	if c.IsBusinessDay(independence) {
+		t.Fatal("Expected July 4th to not be a business day")
+	}
+	if !c.IsHoliday(independence) {
+		t.Fatal("Expected July 4th to be registered as a holiday")
+	}
+	if !c.IsBusinessDay(date.NewDate(2024, time.July, 5)) {
+		t.Fatal("Expected July 5th to be a business day")
+	}
+}
+
+func TestLoadHolidaysCSV(t *testing.T) {
+	c, err := date.LoadHolidaysCSV(strings.NewReader("2024-01-01\n2024-07-04\n2024-12-25\n"))
+	if err != nil {
+		t.Error(err)
+	}
+	if !c.IsHoliday(date.NewDate(2024, time.December, 25)) {
+		t.Fatal("Expected Dec 25th to be a holiday")
+	}
+	if c.IsHoliday(date.NewDate(2024, time.December, 26)) {
+		t.Fatal("Expected Dec 26th to not be a holiday")
+	}
+}
+
+func TestCompose(t *testing.T) {
+	companyHoliday := date.NewDate(2024, time.June, 10)
+	holidays := date.NewHolidayCalendar([]date.Date{companyHoliday})
+	c := date.Compose(date.WeekdayCalendar{}, holidays)
+	if c.IsBusinessDay(companyHoliday) {
+		t.Fatal("Expected composed calendar to reject the company holiday")
+	}
+	if !c.IsBusinessDay(date.NewDate(2024, time.June, 11)) {
+		t.Fatal("Expected composed calendar to accept a plain weekday")
+	}
+	if c.IsBusinessDay(date.NewDate(2024, time.June, 8)) {
+		t.Fatal("Expected composed calendar to reject a weekend")
+	}
+}
+
+func TestAddBusinessDays(t *testing.T) {
+	var c date.WeekdayCalendar
+	fri := date.NewDate(2024, time.June, 7)
+	next := fri.AddBusinessDays(1, c)
+	if !next.Equal(date.NewDate(2024, time.June, 10)) {
+		t.Fatalf("Expected the next business day after Friday to be Monday, got %v", next)
+	}
+
+	mon := date.NewDate(2024, time.June, 10)
+	prev := mon.AddBusinessDays(-1, c)
+	if !prev.Equal(fri) {
+		t.Fatalf("Expected the business day before Monday to be Friday, got %v", prev)
+	}
+}
+
+func TestAddBusinessDaysHolidayCalendarSkipsRuns(t *testing.T) {
+	// A run of three consecutive holidays (Mon-Wed), exercising the indexed
+	// skip in HolidayCalendar.addBusinessDays rather than the naive walk.
+	mon := date.NewDate(2024, time.July, 1)
+	tue := date.NewDate(2024, time.July, 2)
+	wed := date.NewDate(2024, time.July, 3)
+	c := date.NewHolidayCalendar([]date.Date{mon, tue, wed})
+
+	fri := date.NewDate(2024, time.June, 28)
+	next := fri.AddBusinessDays(1, c)
+	want := date.NewDate(2024, time.July, 4)
+	if !next.Equal(want) {
+		t.Fatalf("Expected the next business day after the holiday run to be %v, got %v", want, next)
+	}
+
+	prev := want.AddBusinessDays(-1, c)
+	if !prev.Equal(fri) {
+		t.Fatalf("Expected the business day before the holiday run to be %v, got %v", fri, prev)
+	}
+}
+
+func TestBusinessDaysUntil(t *testing.T) {
+	var c date.WeekdayCalendar
+	fri := date.NewDate(2024, time.June, 7)
+	mon := date.NewDate(2024, time.June, 10)
+	if n := fri.BusinessDaysUntil(mon, c); n != 1 {
+		t.Fatalf("Expected 1 business day between Friday and Monday, got %d", n)
+	}
+	if n := mon.BusinessDaysUntil(fri, c); n != -1 {
+		t.Fatalf("Expected -1 business days between Monday and Friday, got %d", n)
+	}
+}
+
+func TestNextPrevBusinessDay(t *testing.T) {
+	var c date.WeekdayCalendar
+	fri := date.NewDate(2024, time.June, 7)
+	if next := fri.NextBusinessDay(c); !next.Equal(date.NewDate(2024, time.June, 10)) {
+		t.Fatalf("Expected next business day after Friday to be Monday, got %v", next)
+	}
+	mon := date.NewDate(2024, time.June, 10)
+	if prev := mon.PrevBusinessDay(c); !prev.Equal(fri) {
+		t.Fatalf("Expected prev business day before Monday to be Friday, got %v", prev)
+	}
+}
@@ -243,16 +243,25 @@ func padInt(i int) string {
 	return strconv.Itoa(i)
 }
 
+// padYear zero-pads a year to at least 4 digits, as required by ISO 8601.
+func padYear(year int) string {
+	s := strconv.Itoa(year)
+	for len(s) < 4 {
+		s = "0" + s
+	}
+	return s
+}
+
 // MarshalJSON returns a JSON string of the ISO 8601 date format.
 func (d Date) MarshalJSON() ([]byte, error) {
 	year, month, day := d.Date()
-	return []byte(`"` + strconv.Itoa(year) + "-" + padInt(int(month)) + "-" + padInt(day) + `"`), nil
+	return []byte(`"` + padYear(year) + "-" + padInt(int(month)) + "-" + padInt(day) + `"`), nil
 }
 
 // MarshalText returns a string of the ISO 8601 date format.
 func (d Date) MarshalText() ([]byte, error) {
 	year, month, day := d.Date()
-	return []byte(strconv.Itoa(year) + "-" + padInt(int(month)) + "-" + padInt(day)), nil
+	return []byte(padYear(year) + "-" + padInt(int(month)) + "-" + padInt(day)), nil
 }
 
 // Minute returns the minute through [time.Time.Minute].
@@ -356,7 +365,8 @@ func (d *Date) UnmarshalBinary(data []byte) error {
 }
 
 // UnmarshalJSON implements the [json.Unmarshaler] interface.  The date must be
-// a quoted string in ISO 8601 date representation.
+// a quoted string in the ISO 8601 calendar, week date, or ordinal date
+// representation.
 func (d *Date) UnmarshalJSON(data []byte) error {
 	if string(data) == "null" {
 		return nil
@@ -366,22 +376,24 @@ func (d *Date) UnmarshalJSON(data []byte) error {
 		return errors.New("Date.UnmarshalJSON: input is not a JSON string")
 	}
 	data = data[len(`"`) : len(data)-len(`"`)]
-	t, err := time.Parse(ISO8601Date, string(data))
+	parsed, err := parseFlexibleText(string(data))
 	if err != nil {
 		return err
 	}
-	*d = timeToDate(t)
+	*d = parsed
 	return nil
 }
 
 // UnmarshalText implements the [encoding.TextUnmarshaler] interface.  The date
-// must be in the ISO 8601 date representation.
+// must be in the ISO 8601 calendar date representation (e.g. "2024-06-05"),
+// week date representation (e.g. "2024-W23-3"), or ordinal date
+// representation (e.g. "2024-157").
 func (d *Date) UnmarshalText(data []byte) error {
-	t, err := time.Parse(ISO8601Date, string(data))
+	parsed, err := parseFlexibleText(string(data))
 	if err != nil {
 		return err
 	}
-	*d = timeToDate(t)
+	*d = parsed
 	return nil
 }
 
@@ -0,0 +1,197 @@
+package date_test
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"testing/quick"
+	"time"
+
+	"github.com/svip/date"
+)
+
+// genDate produces a uniformly random year in [1, 9999], a random month, and
+// a valid day for that month.
+func genDate(rand *rand.Rand) date.Date {
+	year := rand.Intn(9999) + 1
+	month := time.Month(rand.Intn(12) + 1)
+	day := rand.Intn(daysInMonth(year, month)) + 1
+	return date.NewDate(year, month, day)
+}
+
+// daysInMonth returns the number of days in the given month of year.
+func daysInMonth(year int, month time.Month) int {
+	return date.NewDate(year, month+1, 0).Day()
+}
+
+// dateConfig is a [quick.Config] for properties taking a single [date.Date],
+// since [date.Date] has no exported Generate method for [quick.Check] to
+// discover from outside the package.
+func dateConfig() *quick.Config {
+	return &quick.Config{
+		Values: func(values []reflect.Value, rnd *rand.Rand) {
+			values[0] = reflect.ValueOf(genDate(rnd))
+		},
+	}
+}
+
+// dateAndInt8Config is a [quick.Config] for properties taking a [date.Date]
+// and an int8.
+func dateAndInt8Config() *quick.Config {
+	return &quick.Config{
+		Values: func(values []reflect.Value, rnd *rand.Rand) {
+			values[0] = reflect.ValueOf(genDate(rnd))
+			values[1] = reflect.ValueOf(int8(rnd.Intn(1<<8) - 1<<7))
+		},
+	}
+}
+
+// dateAndInt16Config is a [quick.Config] for properties taking a [date.Date]
+// and an int16.
+func dateAndInt16Config() *quick.Config {
+	return &quick.Config{
+		Values: func(values []reflect.Value, rnd *rand.Rand) {
+			values[0] = reflect.ValueOf(genDate(rnd))
+			values[1] = reflect.ValueOf(int16(rnd.Intn(1<<16) - 1<<15))
+		},
+	}
+}
+
+func TestQuickMarshalJSONRoundtrip(t *testing.T) {
+	f := func(d date.Date) bool {
+		b, err := d.MarshalJSON()
+		if err != nil {
+			return false
+		}
+		var got date.Date
+		if err := got.UnmarshalJSON(b); err != nil {
+			return false
+		}
+		return got.Equal(d)
+	}
+	if err := quick.Check(f, dateConfig()); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestQuickMarshalTextRoundtrip(t *testing.T) {
+	f := func(d date.Date) bool {
+		b, err := d.MarshalText()
+		if err != nil {
+			return false
+		}
+		var got date.Date
+		if err := got.UnmarshalText(b); err != nil {
+			return false
+		}
+		return got.Equal(d)
+	}
+	if err := quick.Check(f, dateConfig()); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestQuickMarshalBinaryRoundtrip(t *testing.T) {
+	f := func(d date.Date) bool {
+		b, err := d.MarshalBinary()
+		if err != nil {
+			return false
+		}
+		var got date.Date
+		if err := got.UnmarshalBinary(b); err != nil {
+			return false
+		}
+		return got.Equal(d)
+	}
+	if err := quick.Check(f, dateConfig()); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestQuickAddDateRoundtrip(t *testing.T) {
+	f := func(d date.Date, n int8) bool {
+		nn := int(n)
+		return d.AddDate(0, 0, nn).AddDate(0, 0, -nn).Equal(d)
+	}
+	if err := quick.Check(f, dateAndInt8Config()); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestQuickParseRoundtrip(t *testing.T) {
+	f := func(d date.Date) bool {
+		got, err := date.Parse(date.ISO8601Date, d.String())
+		if err != nil {
+			return false
+		}
+		return got.Equal(d)
+	}
+	if err := quick.Check(f, dateConfig()); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestQuickSubMatchesAddDate(t *testing.T) {
+	// n is kept well within the ~292-year range a time.Duration can hold, so
+	// that Sub doesn't saturate and obscure the invariant being tested.
+	f := func(a date.Date, n int16) bool {
+		b := a.AddDate(0, 0, int(n))
+		got := int(b.Sub(a) / (24 * time.Hour))
+		return a.AddDate(0, 0, got).Equal(b)
+	}
+	if err := quick.Check(f, dateAndInt16Config()); err != nil {
+		t.Error(err)
+	}
+}
+
+// monthByName maps a [time.Month]'s English name back to its value, for
+// evalGoString.
+var monthByName = func() map[string]time.Month {
+	m := make(map[string]time.Month, 12)
+	for i := 1; i <= 12; i++ {
+		m[time.Month(i).String()] = time.Month(i)
+	}
+	return m
+}()
+
+// evalGoString is a tiny handwritten evaluator for the output of
+// [date.Date.GoString], used to check that it round-trips to the same Date.
+func evalGoString(s string) (date.Date, error) {
+	if !strings.HasPrefix(s, "date.NewDate(") || !strings.HasSuffix(s, ")") {
+		return date.Date{}, fmt.Errorf("date: cannot evaluate GoString %q", s)
+	}
+	s = strings.TrimSuffix(strings.TrimPrefix(s, "date.NewDate("), ")")
+	parts := strings.SplitN(s, ", ", 3)
+	if len(parts) != 3 {
+		return date.Date{}, fmt.Errorf("date: cannot evaluate GoString %q", s)
+	}
+	year, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return date.Date{}, err
+	}
+	month, ok := monthByName[strings.TrimPrefix(parts[1], "time.")]
+	if !ok {
+		return date.Date{}, fmt.Errorf("date: unknown month %q", parts[1])
+	}
+	day, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return date.Date{}, err
+	}
+	return date.NewDate(year, month, day), nil
+}
+
+func TestQuickGoStringRoundtrip(t *testing.T) {
+	f := func(d date.Date) bool {
+		got, err := evalGoString(d.GoString())
+		if err != nil {
+			return false
+		}
+		return got.Equal(d)
+	}
+	if err := quick.Check(f, dateConfig()); err != nil {
+		t.Error(err)
+	}
+}
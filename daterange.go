@@ -0,0 +1,225 @@
+package date
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DateRange represents a half-open interval of [Date] values, including
+// Start but excluding End, i.e. [Start, End).
+type DateRange struct {
+	Start Date
+	End   Date
+}
+
+// NewDateRange returns a new [DateRange] running from start (inclusive) to
+// end (exclusive).
+func NewDateRange(start Date, end Date) DateRange {
+	return DateRange{Start: start, End: end}
+}
+
+// RangeSince returns a [DateRange] starting at start and running for days
+// days.
+func RangeSince(start Date, days int) DateRange {
+	return DateRange{Start: start, End: start.AddDate(0, 0, days)}
+}
+
+// RangeMonth returns a [DateRange] covering the given calendar month, from
+// its first day up to, but excluding, the first day of the following month.
+func RangeMonth(year int, month time.Month) DateRange {
+	start := NewDate(year, month, 1)
+	return DateRange{Start: start, End: start.AddDate(0, 1, 0)}
+}
+
+// isEmpty returns true if the [DateRange] contains no dates.
+func (r DateRange) isEmpty() bool {
+	return !r.Start.Before(r.End)
+}
+
+// Contains returns true if d falls within the [DateRange], i.e. d is on or
+// after Start and strictly before End.
+func (r DateRange) Contains(d Date) bool {
+	return !d.Before(r.Start) && d.Before(r.End)
+}
+
+// Overlaps returns true if the [DateRange] shares at least one [Date] with o.
+func (r DateRange) Overlaps(o DateRange) bool {
+	if r.isEmpty() || o.isEmpty() {
+		return false
+	}
+	return r.Start.Before(o.End) && o.Start.Before(r.End)
+}
+
+// Intersect returns the [DateRange] common to both r and o, and false if they
+// do not overlap.
+func (r DateRange) Intersect(o DateRange) (DateRange, bool) {
+	start := dateMax(r.Start, o.Start)
+	end := dateMin(r.End, o.End)
+	if !start.Before(end) {
+		return DateRange{}, false
+	}
+	return DateRange{Start: start, End: end}, true
+}
+
+// isAdjacent returns true if r and o touch without overlapping, i.e. one
+// starts exactly where the other ends.
+func (r DateRange) isAdjacent(o DateRange) bool {
+	return r.End.Equal(o.Start) || o.End.Equal(r.Start)
+}
+
+// Union returns the result of merging r and o. If they overlap or are
+// adjacent, it returns a single [DateRange] spanning both; otherwise it
+// returns both ranges, ordered by Start. It returns an error if either r or o
+// is empty.
+func (r DateRange) Union(o DateRange) ([]DateRange, error) {
+	if r.isEmpty() || o.isEmpty() {
+		return nil, errors.New("date: cannot union an empty DateRange")
+	}
+	if r.Overlaps(o) || r.isAdjacent(o) {
+		return []DateRange{{Start: dateMin(r.Start, o.Start), End: dateMax(r.End, o.End)}}, nil
+	}
+	if o.Start.Before(r.Start) {
+		return []DateRange{o, r}, nil
+	}
+	return []DateRange{r, o}, nil
+}
+
+// Difference returns the parts of r that are not covered by o. The result may
+// be empty (r is entirely covered by o), contain one [DateRange] (o covers
+// one end of r, or none of it), or two (o splits r in two).
+func (r DateRange) Difference(o DateRange) []DateRange {
+	inter, ok := r.Intersect(o)
+	if !ok {
+		return []DateRange{r}
+	}
+	var out []DateRange
+	if r.Start.Before(inter.Start) {
+		out = append(out, DateRange{Start: r.Start, End: inter.Start})
+	}
+	if inter.End.Before(r.End) {
+		out = append(out, DateRange{Start: inter.End, End: r.End})
+	}
+	return out
+}
+
+// Days returns the number of days in the [DateRange]. It returns 0 if End is
+// not after Start.
+func (r DateRange) Days() int {
+	d := int(r.End.Sub(r.Start) / (24 * time.Hour))
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
+// Split divides the [DateRange] into consecutive [DateRange] values of at
+// most step days each; the last one may be shorter. It returns nil if step is
+// not positive.
+func (r DateRange) Split(step int) []DateRange {
+	if step <= 0 {
+		return nil
+	}
+	var out []DateRange
+	for cur := r.Start; cur.Before(r.End); {
+		next := cur.AddDate(0, 0, step)
+		if next.After(r.End) {
+			next = r.End
+		}
+		out = append(out, DateRange{Start: cur, End: next})
+		cur = next
+	}
+	return out
+}
+
+// ForEach calls f for every [Date] in the [DateRange], from Start up to, but
+// excluding, End, stopping early if f returns false.
+//
+// There is no range-over-func iterator here (no [iter.Seq]-returning All)
+// since this repo has no go.mod pinning a Go version and the toolchain it
+// builds with predates Go 1.23; revisit once that's no longer the case.
+func (r DateRange) ForEach(f func(Date) bool) {
+	for d := r.Start; d.Before(r.End); d = d.AddDate(0, 0, 1) {
+		if !f(d) {
+			return
+		}
+	}
+}
+
+// MarshalJSON returns a JSON string of the "start/end" ISO 8601 interval
+// form.
+func (r DateRange) MarshalJSON() ([]byte, error) {
+	text, err := r.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, 0, len(text)+2)
+	buf = append(buf, '"')
+	buf = append(buf, text...)
+	buf = append(buf, '"')
+	return buf, nil
+}
+
+// MarshalText returns a string of the "start/end" ISO 8601 interval form.
+func (r DateRange) MarshalText() ([]byte, error) {
+	start, err := r.Start.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	end, err := r.End.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, 0, len(start)+1+len(end))
+	buf = append(buf, start...)
+	buf = append(buf, '/')
+	buf = append(buf, end...)
+	return buf, nil
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface. The range must
+// be a quoted string in the "start/end" ISO 8601 interval form.
+func (r *DateRange) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return errors.New("DateRange.UnmarshalJSON: input is not a JSON string")
+	}
+	return r.UnmarshalText(data[1 : len(data)-1])
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface. The
+// range must be in the "start/end" ISO 8601 interval form.
+func (r *DateRange) UnmarshalText(data []byte) error {
+	start, end, ok := strings.Cut(string(data), "/")
+	if !ok {
+		return fmt.Errorf("date: invalid DateRange %q, expected \"start/end\"", data)
+	}
+	var s, e Date
+	if err := s.UnmarshalText([]byte(start)); err != nil {
+		return err
+	}
+	if err := e.UnmarshalText([]byte(end)); err != nil {
+		return err
+	}
+	r.Start, r.End = s, e
+	return nil
+}
+
+// dateMin returns the earlier of a and b.
+func dateMin(a Date, b Date) Date {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+// dateMax returns the later of a and b.
+func dateMax(a Date, b Date) Date {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
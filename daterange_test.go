@@ -0,0 +1,155 @@
+package date_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/svip/date"
+)
+
+func TestDateRangeContains(t *testing.T) {
+	r := date.NewDateRange(date.NewDate(2024, time.June, 1), date.NewDate(2024, time.June, 5))
+	if !r.Contains(date.NewDate(2024, time.June, 1)) {
+		t.Fatal("Expected range to contain its Start")
+	}
+	if r.Contains(date.NewDate(2024, time.June, 5)) {
+		t.Fatal("Expected range to not contain its End")
+	}
+	if !r.Contains(date.NewDate(2024, time.June, 3)) {
+		t.Fatal("Expected range to contain a date in the middle")
+	}
+}
+
+func TestDateRangeOverlaps(t *testing.T) {
+	r1 := date.NewDateRange(date.NewDate(2024, time.June, 1), date.NewDate(2024, time.June, 10))
+	r2 := date.NewDateRange(date.NewDate(2024, time.June, 5), date.NewDate(2024, time.June, 15))
+	r3 := date.NewDateRange(date.NewDate(2024, time.June, 10), date.NewDate(2024, time.June, 20))
+	if !r1.Overlaps(r2) {
+		t.Fatal("Expected r1 and r2 to overlap")
+	}
+	if r1.Overlaps(r3) {
+		t.Fatal("Expected r1 and r3 to not overlap since r3 starts where r1 ends")
+	}
+}
+
+func TestDateRangeIntersect(t *testing.T) {
+	r1 := date.NewDateRange(date.NewDate(2024, time.June, 1), date.NewDate(2024, time.June, 10))
+	r2 := date.NewDateRange(date.NewDate(2024, time.June, 5), date.NewDate(2024, time.June, 15))
+	inter, ok := r1.Intersect(r2)
+	if !ok {
+		t.Fatal("Expected an intersection")
+	}
+	if !inter.Start.Equal(date.NewDate(2024, time.June, 5)) || !inter.End.Equal(date.NewDate(2024, time.June, 10)) {
+		t.Fatalf("Wrong intersection, got %v", inter)
+	}
+
+	r3 := date.NewDateRange(date.NewDate(2024, time.June, 10), date.NewDate(2024, time.June, 20))
+	if _, ok := r1.Intersect(r3); ok {
+		t.Fatal("Expected no intersection between adjacent ranges")
+	}
+}
+
+func TestDateRangeUnion(t *testing.T) {
+	r1 := date.NewDateRange(date.NewDate(2024, time.June, 1), date.NewDate(2024, time.June, 10))
+	r2 := date.NewDateRange(date.NewDate(2024, time.June, 5), date.NewDate(2024, time.June, 15))
+	merged, err := r1.Union(r2)
+	if err != nil {
+		t.Error(err)
+	}
+	if len(merged) != 1 {
+		t.Fatalf("Expected a single merged range, got %d", len(merged))
+	}
+	if !merged[0].Start.Equal(date.NewDate(2024, time.June, 1)) || !merged[0].End.Equal(date.NewDate(2024, time.June, 15)) {
+		t.Fatalf("Wrong merged range, got %v", merged[0])
+	}
+
+	r3 := date.NewDateRange(date.NewDate(2024, time.July, 1), date.NewDate(2024, time.July, 10))
+	disjoint, err := r1.Union(r3)
+	if err != nil {
+		t.Error(err)
+	}
+	if len(disjoint) != 2 {
+		t.Fatalf("Expected two disjoint ranges, got %d", len(disjoint))
+	}
+}
+
+func TestDateRangeDifference(t *testing.T) {
+	r1 := date.NewDateRange(date.NewDate(2024, time.June, 1), date.NewDate(2024, time.June, 10))
+	r2 := date.NewDateRange(date.NewDate(2024, time.June, 3), date.NewDate(2024, time.June, 5))
+	diff := r1.Difference(r2)
+	if len(diff) != 2 {
+		t.Fatalf("Expected the range to be split in two, got %d", len(diff))
+	}
+	if !diff[0].Start.Equal(date.NewDate(2024, time.June, 1)) || !diff[0].End.Equal(date.NewDate(2024, time.June, 3)) {
+		t.Fatalf("Wrong first difference range, got %v", diff[0])
+	}
+	if !diff[1].Start.Equal(date.NewDate(2024, time.June, 5)) || !diff[1].End.Equal(date.NewDate(2024, time.June, 10)) {
+		t.Fatalf("Wrong second difference range, got %v", diff[1])
+	}
+}
+
+func TestDateRangeDays(t *testing.T) {
+	r := date.NewDateRange(date.NewDate(2024, time.June, 1), date.NewDate(2024, time.June, 10))
+	if r.Days() != 9 {
+		t.Fatalf("Expected 9 days, got %d", r.Days())
+	}
+}
+
+func TestDateRangeSplit(t *testing.T) {
+	r := date.NewDateRange(date.NewDate(2024, time.June, 1), date.NewDate(2024, time.June, 10))
+	parts := r.Split(4)
+	if len(parts) != 3 {
+		t.Fatalf("Expected 3 parts, got %d", len(parts))
+	}
+	if parts[2].Days() != 1 {
+		t.Fatalf("Expected the last part to be 1 day, got %d", parts[2].Days())
+	}
+}
+
+func TestDateRangeForEach(t *testing.T) {
+	r := date.NewDateRange(date.NewDate(2024, time.June, 1), date.NewDate(2024, time.June, 4))
+	var got []date.Date
+	r.ForEach(func(d date.Date) bool {
+		got = append(got, d)
+		return true
+	})
+	if len(got) != 3 {
+		t.Fatalf("Expected 3 dates, got %d", len(got))
+	}
+	if !got[0].Equal(date.NewDate(2024, time.June, 1)) || !got[2].Equal(date.NewDate(2024, time.June, 3)) {
+		t.Fatalf("Wrong dates, got %v", got)
+	}
+}
+
+func TestDateRangeMarshalJSON(t *testing.T) {
+	r := date.NewDateRange(date.NewDate(2024, time.June, 1), date.NewDate(2024, time.June, 30))
+	b, err := json.Marshal(r)
+	if err != nil {
+		t.Error(err)
+	}
+	if string(b) != `"2024-06-01/2024-06-30"` {
+		t.Fatalf("Wrong JSON result, got %v", string(b))
+	}
+}
+
+func TestDateRangeUnmarshalJSON(t *testing.T) {
+	var r date.DateRange
+	err := json.Unmarshal([]byte(`"2024-06-01/2024-06-30"`), &r)
+	if err != nil {
+		t.Error(err)
+	}
+	if !r.Start.Equal(date.NewDate(2024, time.June, 1)) || !r.End.Equal(date.NewDate(2024, time.June, 30)) {
+		t.Fatalf("Wrong result, got %v", r)
+	}
+}
+
+func TestRangeMonth(t *testing.T) {
+	r := date.RangeMonth(2024, time.February)
+	if !r.Start.Equal(date.NewDate(2024, time.February, 1)) {
+		t.Fatalf("Expected Start to be Feb 1, got %v", r.Start)
+	}
+	if !r.End.Equal(date.NewDate(2024, time.March, 1)) {
+		t.Fatalf("Expected End to be Mar 1, got %v", r.End)
+	}
+}
@@ -0,0 +1,118 @@
+package date
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+var isoWeekPattern = regexp.MustCompile(`^([0-9]{4})-W([0-9]{2})-([0-9])$`)
+
+var ordinalPattern = regexp.MustCompile(`^([0-9]{4})-([0-9]{3})$`)
+
+// isoWeekday returns the ISO 8601 weekday for d, where Monday is 1 and
+// Sunday is 7.
+func isoWeekday(d Date) int {
+	wd := int(d.Weekday())
+	if wd == 0 {
+		return 7
+	}
+	return wd
+}
+
+// FormatISOWeek formats d in the ISO 8601 week date form, YYYY-Www-D, e.g.
+// "2024-W23-3".
+func FormatISOWeek(d Date) string {
+	year, week := d.ISOWeek()
+	return fmt.Sprintf("%04d-W%02d-%d", year, week, isoWeekday(d))
+}
+
+// ParseISOWeek parses an ISO 8601 week date in the form YYYY-Www-D, e.g.
+// "2024-W23-3".
+func ParseISOWeek(s string) (Date, error) {
+	m := isoWeekPattern.FindStringSubmatch(s)
+	if m == nil {
+		return Date{}, fmt.Errorf("date: invalid ISO week date %q", s)
+	}
+	year, _ := strconv.Atoi(m[1])
+	week, _ := strconv.Atoi(m[2])
+	weekday, _ := strconv.Atoi(m[3])
+	return NewDateFromISOWeek(year, week, weekday)
+}
+
+// NewDateFromISOWeek returns the [Date] for the given ISO 8601 week-year,
+// week number (1-53) and weekday (1 for Monday through 7 for Sunday).
+//
+// Note that the ISO week-year does not always match the calendar year: for
+// example, 2024-W01-1 is 2024-01-01, but 2023-W52-7 is 2023-12-31 and
+// 2021-W01-1 is 2021-01-04.
+func NewDateFromISOWeek(year int, week int, weekday int) (Date, error) {
+	if weekday < 1 || weekday > 7 {
+		return Date{}, fmt.Errorf("date: invalid ISO weekday %d, must be 1-7", weekday)
+	}
+	if week < 1 || week > 53 {
+		return Date{}, fmt.Errorf("date: invalid ISO week %d, must be 1-53", week)
+	}
+	// Jan 4 always falls in week 1 of the ISO week-year, so we anchor on it
+	// and walk forward by whole weeks, then adjust for the target weekday.
+	jan4 := NewDate(year, time.January, 4)
+	w4 := isoWeekday(jan4)
+	d := jan4.AddDate(0, 0, (week-1)*7+(weekday-w4))
+
+	// Not every ISO week-year has 53 weeks, so the arithmetic above can walk
+	// past the end of the requested week-year; catch that here rather than
+	// silently returning the wrong date.
+	gotYear, gotWeek := d.ISOWeek()
+	if gotYear != year || gotWeek != week {
+		return Date{}, fmt.Errorf("date: year %d has no ISO week %d", year, week)
+	}
+	return d, nil
+}
+
+// FormatOrdinal formats d in the ISO 8601 ordinal date form, YYYY-DDD, e.g.
+// "2024-157".
+func FormatOrdinal(d Date) string {
+	return fmt.Sprintf("%04d-%03d", d.Year(), d.YearDay())
+}
+
+// ParseOrdinal parses an ISO 8601 ordinal date in the form YYYY-DDD, e.g.
+// "2024-157".
+func ParseOrdinal(s string) (Date, error) {
+	m := ordinalPattern.FindStringSubmatch(s)
+	if m == nil {
+		return Date{}, fmt.Errorf("date: invalid ordinal date %q", s)
+	}
+	year, _ := strconv.Atoi(m[1])
+	day, _ := strconv.Atoi(m[2])
+	return NewDateFromOrdinal(year, day)
+}
+
+// NewDateFromOrdinal returns the [Date] for the day-th day (1-366) of year.
+func NewDateFromOrdinal(year int, day int) (Date, error) {
+	if day < 1 || day > 366 {
+		return Date{}, fmt.Errorf("date: invalid ordinal day %d, must be 1-366", day)
+	}
+	d := NewDate(year, time.January, 1).AddDate(0, 0, day-1)
+	if d.Year() != year {
+		return Date{}, fmt.Errorf("date: year %d has no ordinal day %d", year, day)
+	}
+	return d, nil
+}
+
+// parseFlexibleText parses s as an ISO 8601 calendar date, week date, or
+// ordinal date, dispatching on whichever pattern it matches.
+func parseFlexibleText(s string) (Date, error) {
+	switch {
+	case isoWeekPattern.MatchString(s):
+		return ParseISOWeek(s)
+	case ordinalPattern.MatchString(s):
+		return ParseOrdinal(s)
+	default:
+		t, err := time.Parse(ISO8601Date, s)
+		if err != nil {
+			return Date{}, err
+		}
+		return timeToDate(t), nil
+	}
+}
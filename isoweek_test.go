@@ -0,0 +1,111 @@
+package date_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/svip/date"
+)
+
+func TestFormatISOWeek(t *testing.T) {
+	d := date.NewDate(2024, time.June, 5)
+	if s := date.FormatISOWeek(d); s != "2024-W23-3" {
+		t.Fatalf("Wrong ISO week format, got %v", s)
+	}
+}
+
+func TestParseISOWeek(t *testing.T) {
+	tests := []struct {
+		in   string
+		want date.Date
+	}{
+		{"2024-W23-3", date.NewDate(2024, time.June, 5)},
+		{"2024-W01-1", date.NewDate(2024, time.January, 1)},
+		{"2023-W52-7", date.NewDate(2023, time.December, 31)},
+		{"2021-W01-1", date.NewDate(2021, time.January, 4)},
+	}
+	for _, tt := range tests {
+		got, err := date.ParseISOWeek(tt.in)
+		if err != nil {
+			t.Errorf("ParseISOWeek(%q): %v", tt.in, err)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("ParseISOWeek(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+
+	if _, err := date.ParseISOWeek("not-a-week"); err == nil {
+		t.Fatal("Expected an error for a malformed ISO week date")
+	}
+}
+
+func TestNewDateFromISOWeekInvalid(t *testing.T) {
+	if _, err := date.NewDateFromISOWeek(2024, 0, 1); err == nil {
+		t.Fatal("Expected an error for week 0")
+	}
+	if _, err := date.NewDateFromISOWeek(2024, 1, 8); err == nil {
+		t.Fatal("Expected an error for weekday 8")
+	}
+	// 2023 only has 52 ISO weeks.
+	if _, err := date.NewDateFromISOWeek(2023, 53, 1); err == nil {
+		t.Fatal("Expected an error for a week-year with no week 53")
+	}
+}
+
+func TestFormatOrdinal(t *testing.T) {
+	d := date.NewDate(2024, time.June, 5)
+	if s := date.FormatOrdinal(d); s != "2024-157" {
+		t.Fatalf("Wrong ordinal format, got %v", s)
+	}
+}
+
+func TestParseOrdinal(t *testing.T) {
+	got, err := date.ParseOrdinal("2024-157")
+	if err != nil {
+		t.Error(err)
+	}
+	if !got.Equal(date.NewDate(2024, time.June, 5)) {
+		t.Fatalf("ParseOrdinal(\"2024-157\") = %v, want 2024-06-05", got)
+	}
+
+	if _, err := date.ParseOrdinal("2023-366"); err == nil {
+		t.Fatal("Expected an error for day 366 in a non-leap year")
+	}
+}
+
+func TestUnmarshalTextISOWeekAndOrdinal(t *testing.T) {
+	var d date.Date
+	if err := d.UnmarshalText([]byte("2024-W23-3")); err != nil {
+		t.Error(err)
+	}
+	if !d.Equal(date.NewDate(2024, time.June, 5)) {
+		t.Fatalf("Wrong result unmarshaling ISO week text, got %v", d)
+	}
+
+	d = date.Date{}
+	if err := d.UnmarshalText([]byte("2024-157")); err != nil {
+		t.Error(err)
+	}
+	if !d.Equal(date.NewDate(2024, time.June, 5)) {
+		t.Fatalf("Wrong result unmarshaling ordinal text, got %v", d)
+	}
+}
+
+func TestUnmarshalJSONISOWeekAndOrdinal(t *testing.T) {
+	var d date.Date
+	if err := d.UnmarshalJSON([]byte(`"2024-W23-3"`)); err != nil {
+		t.Error(err)
+	}
+	if !d.Equal(date.NewDate(2024, time.June, 5)) {
+		t.Fatalf("Wrong result unmarshaling ISO week JSON, got %v", d)
+	}
+
+	d = date.Date{}
+	if err := d.UnmarshalJSON([]byte(`"2024-157"`)); err != nil {
+		t.Error(err)
+	}
+	if !d.Equal(date.NewDate(2024, time.June, 5)) {
+		t.Fatalf("Wrong result unmarshaling ordinal JSON, got %v", d)
+	}
+}
@@ -0,0 +1,74 @@
+package date
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"time"
+)
+
+// Value implements the [driver.Valuer] interface, returning a [time.Time] at
+// UTC midnight, which is what most database drivers expect for a DATE
+// column.
+func (d Date) Value() (driver.Value, error) {
+	return d.t, nil
+}
+
+// Scan implements the [sql.Scanner] interface.
+//
+// It accepts a [time.Time] (as returned by most drivers for a DATE column), a
+// []byte or string in ISO 8601 date form, nil (which resets the [Date] to its
+// zero value), and an int64 interpreted as the number of days since the Unix
+// epoch (as some drivers surface DATE columns).
+func (d *Date) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*d = Date{}
+		return nil
+	case time.Time:
+		*d = timeToDate(v)
+		return nil
+	case []byte:
+		return d.UnmarshalText(v)
+	case string:
+		return d.UnmarshalText([]byte(v))
+	case int64:
+		*d = unixEpoch.AddDate(0, 0, int(v))
+		return nil
+	default:
+		return fmt.Errorf("date: unsupported Scan type %T", src)
+	}
+}
+
+// unixEpoch is the Date from which int64 day counts are measured in Scan.
+// AddDate is used instead of time.Unix so the conversion never passes through
+// time.Local.
+var unixEpoch = NewDate(1970, time.January, 1)
+
+// NullDate represents a [Date] that may be null, analogous to [sql.NullTime].
+// It implements the [driver.Valuer] and [sql.Scanner] interfaces so it can be
+// used directly as a scan destination or query argument.
+type NullDate struct {
+	Date  Date
+	Valid bool
+}
+
+// Value implements the [driver.Valuer] interface.
+func (n NullDate) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Date.Value()
+}
+
+// Scan implements the [sql.Scanner] interface.
+func (n *NullDate) Scan(src any) error {
+	if src == nil {
+		n.Date, n.Valid = Date{}, false
+		return nil
+	}
+	if err := n.Date.Scan(src); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
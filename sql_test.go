@@ -0,0 +1,124 @@
+package date_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/svip/date"
+)
+
+func TestDateValue(t *testing.T) {
+	d := date.NewDate(2024, time.June, 5)
+	v, err := d.Value()
+	if err != nil {
+		t.Error(err)
+	}
+	tv, ok := v.(time.Time)
+	if !ok {
+		t.Fatalf("Expected a time.Time, got %T", v)
+	}
+	if !tv.Equal(time.Date(2024, time.June, 5, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("Wrong time.Time result, got %v", tv)
+	}
+}
+
+func TestDateScan(t *testing.T) {
+	var d date.Date
+
+	if err := d.Scan(time.Date(2024, time.June, 5, 15, 6, 7, 0, time.UTC)); err != nil {
+		t.Error(err)
+	}
+	if d.String() != "2024-06-05" {
+		t.Fatalf("Wrong result scanning time.Time, got %v", d)
+	}
+
+	d = date.Date{}
+	if err := d.Scan("2024-06-05"); err != nil {
+		t.Error(err)
+	}
+	if d.String() != "2024-06-05" {
+		t.Fatalf("Wrong result scanning string, got %v", d)
+	}
+
+	d = date.Date{}
+	if err := d.Scan([]byte("2024-06-05")); err != nil {
+		t.Error(err)
+	}
+	if d.String() != "2024-06-05" {
+		t.Fatalf("Wrong result scanning []byte, got %v", d)
+	}
+
+	d = date.NewDate(2024, time.June, 5)
+	if err := d.Scan(nil); err != nil {
+		t.Error(err)
+	}
+	if !d.IsZero() {
+		t.Fatalf("Expected Scan(nil) to reset the Date to zero, got %v", d)
+	}
+
+	d = date.Date{}
+	if err := d.Scan(int64(19879)); err != nil {
+		t.Error(err)
+	}
+	if d.String() != "2024-06-05" {
+		t.Fatalf("Wrong result scanning int64 day count, got %v", d)
+	}
+
+	if err := d.Scan(3.14); err == nil {
+		t.Fatal("Expected an error scanning an unsupported type, got none")
+	}
+}
+
+func TestDateScanInt64IgnoresLocalTimeZone(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("could not load America/New_York: %v", err)
+	}
+	old := time.Local
+	time.Local = loc
+	defer func() { time.Local = old }()
+
+	var d date.Date
+	if err := d.Scan(int64(19879)); err != nil {
+		t.Error(err)
+	}
+	if d.String() != "2024-06-05" {
+		t.Fatalf("Expected scanning an int64 day count to be timezone-independent, got %v", d)
+	}
+}
+
+func TestNullDate(t *testing.T) {
+	var n date.NullDate
+	v, err := n.Value()
+	if err != nil {
+		t.Error(err)
+	}
+	if v != nil {
+		t.Fatalf("Expected a nil Value for an invalid NullDate, got %v", v)
+	}
+
+	if err := n.Scan(nil); err != nil {
+		t.Error(err)
+	}
+	if n.Valid {
+		t.Fatal("Expected NullDate to be invalid after scanning nil")
+	}
+
+	if err := n.Scan("2024-06-05"); err != nil {
+		t.Error(err)
+	}
+	if !n.Valid {
+		t.Fatal("Expected NullDate to be valid after scanning a date string")
+	}
+	if n.Date.String() != "2024-06-05" {
+		t.Fatalf("Wrong NullDate.Date result, got %v", n.Date)
+	}
+
+	v, err = n.Value()
+	if err != nil {
+		t.Error(err)
+	}
+	if _, ok := v.(time.Time); !ok {
+		t.Fatalf("Expected a time.Time, got %T", v)
+	}
+}